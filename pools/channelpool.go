@@ -30,6 +30,8 @@ type ChannelPool struct {
 	sleepOnErrorInterval time.Duration
 	globalQosCount       int
 	ackNoWait            bool
+	healthMonitor        *HealthMonitor
+	healthMonitorLock    sync.Mutex // separate from poolLock, which Initialize holds while creating channels
 }
 
 // NewChannelPool creates hosting structure for the ChannelPool.
@@ -154,9 +156,35 @@ func (cp *ChannelPool) createChannelHost(channelID uint64, ackable bool) (*model
 		channelHost.Channel.Confirm(cp.ackNoWait)
 	}
 
+	if hm := cp.currentHealthMonitor(); hm != nil {
+		hm.Watch(channelHost, ackable)
+	}
+
 	return channelHost, nil
 }
 
+// currentHealthMonitor returns the pool's HealthMonitor, or nil if StartHealthMonitor hasn't
+// been called.
+func (cp *ChannelPool) currentHealthMonitor() *HealthMonitor {
+	cp.healthMonitorLock.Lock()
+	defer cp.healthMonitorLock.Unlock()
+	return cp.healthMonitor
+}
+
+// StartHealthMonitor attaches a HealthMonitor to this pool: every channel created from this
+// point on (including those rebuilt by the monitor itself) gets its NotifyClose/NotifyBlocked
+// watched in the background, with jittered exponential backoff in place of GetChannel's inline
+// fixed-interval retry. Call this before Initialize to also cover the initial batch of channels.
+func (cp *ChannelPool) StartHealthMonitor(config HealthMonitorConfig) *HealthMonitor {
+	hm := NewHealthMonitor(cp, config)
+
+	cp.healthMonitorLock.Lock()
+	cp.healthMonitor = hm
+	cp.healthMonitorLock.Unlock()
+
+	return hm
+}
+
 func (cp *ChannelPool) handleError(err error) {
 	go func() { cp.errors <- err }()
 }
@@ -210,12 +238,11 @@ func (cp *ChannelPool) GetChannel() (*models.ChannelHost, error) {
 
 			channelHost, err = cp.createChannelHost(replacementChannelID, false)
 			if err != nil {
+				if cp.sleepOnErrorInterval > 0 {
+					time.Sleep(cp.sleepOnErrorInterval)
+				}
 				continue
 			}
-
-			if cp.sleepOnErrorInterval > 0 {
-				time.Sleep(cp.sleepOnErrorInterval)
-			}
 		}
 
 		cp.UnflagChannel(replacementChannelID)
@@ -226,12 +253,41 @@ func (cp *ChannelPool) GetChannel() (*models.ChannelHost, error) {
 
 // ReturnChannel puts the connection back in the queue while also returning a pointer to the caller.
 // Developer has to manually return the Channel and helps maintain a Round Robin on Channels and their resources.
+//
+// If a stale host with the same ChannelID is still sitting in the queue - e.g. the health
+// monitor rebuilt a channel that closed while it was idle in the pool, so nobody ever dequeued
+// the dead original - that stale entry is dropped first, so a rebuild never leaves two hosts
+// claiming the same ChannelID and net-growing the pool's free queue.
 func (cp *ChannelPool) ReturnChannel(chanHost *models.ChannelHost) {
 	if chanHost.IsAckable() {
-		cp.ackChannels.Put(chanHost)
+		cp.ackChannels.Put(cp.dedupeByChannelID(cp.ackChannels, chanHost)...)
 	} else {
-		cp.channels.Put(chanHost)
+		cp.channels.Put(cp.dedupeByChannelID(cp.channels, chanHost)...)
+	}
+}
+
+// dedupeByChannelID drains q, drops any existing entry sharing chanHost.ChannelID, and returns
+// the survivors with chanHost appended - ready to be Put back as a single batch.
+func (cp *ChannelPool) dedupeByChannelID(q *queue.Queue, chanHost *models.ChannelHost) []interface{} {
+	length := q.Len()
+	if length == 0 {
+		return []interface{}{chanHost}
 	}
+
+	items, err := q.Get(length)
+	if err != nil {
+		return []interface{}{chanHost}
+	}
+
+	survivors := make([]interface{}, 0, len(items)+1)
+	for _, item := range items {
+		if existing, ok := item.(*models.ChannelHost); ok && existing.ChannelID == chanHost.ChannelID {
+			continue // stale duplicate left behind by an out-of-band rebuild - chanHost replaces it
+		}
+		survivors = append(survivors, item)
+	}
+
+	return append(survivors, chanHost)
 }
 
 // GetAckableChannel gets an ackable channel based on whats available in AckChannelPool queue.
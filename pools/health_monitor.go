@@ -0,0 +1,199 @@
+package pools
+
+import (
+	"math"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/houseofcat/turbocookedrabbit/models"
+
+	"github.com/streadway/amqp"
+)
+
+// HealthStatus describes how a connection (and the channels riding on it) is currently doing.
+type HealthStatus int
+
+const (
+	// Healthy means the connection is up and accepting publishes/consumes normally.
+	Healthy HealthStatus = iota
+	// Blocked means the broker asked the connection to pause via a connection.blocked
+	// notification (usually a memory or disk alarm) - the TCP connection itself is still up.
+	Blocked
+	// Reconnecting means a channel on this connection closed and a rebuild is in flight.
+	Reconnecting
+	// Failed means the most recent rebuild attempt errored; another attempt follows after
+	// the next backoff interval.
+	Failed
+)
+
+// HealthMonitorConfig tunes the backoff HealthMonitor uses while rebuilding a closed channel.
+type HealthMonitorConfig struct {
+	BaseBackoff time.Duration
+	MaxBackoff  time.Duration
+}
+
+// HealthMonitor watches every ChannelHost it's given via Watch for NotifyClose and
+// NotifyBlocked in the background. On a close it flags the channel and rebuilds it
+// asynchronously with jittered exponential backoff - in place of the fixed-interval inline
+// retry that GetChannel/GetAckableChannel otherwise fall back on. A blocked/unblocked
+// notification only updates the connection's HealthStatus.
+type HealthMonitor struct {
+	pool          *ChannelPool
+	config        HealthMonitorConfig
+	onStateChange func(connectionID uint64, status HealthStatus)
+	callbackLock  *sync.Mutex
+	statusLock    *sync.RWMutex
+	statuses      map[uint64]HealthStatus
+	stop          chan struct{}
+}
+
+// NewHealthMonitor creates a HealthMonitor for pool. Zero-value BaseBackoff/MaxBackoff fall
+// back to 500ms and 30s respectively. Prefer ChannelPool.StartHealthMonitor over calling this
+// directly so newly rebuilt channels get picked up automatically.
+func NewHealthMonitor(pool *ChannelPool, config HealthMonitorConfig) *HealthMonitor {
+	if config.BaseBackoff <= 0 {
+		config.BaseBackoff = 500 * time.Millisecond
+	}
+	if config.MaxBackoff <= 0 {
+		config.MaxBackoff = 30 * time.Second
+	}
+
+	return &HealthMonitor{
+		pool:         pool,
+		config:       config,
+		callbackLock: &sync.Mutex{},
+		statusLock:   &sync.RWMutex{},
+		statuses:     make(map[uint64]HealthStatus),
+		stop:         make(chan struct{}),
+	}
+}
+
+// OnStateChange registers a callback invoked every time a connection's HealthStatus changes,
+// so applications can drive readiness probes and circuit-break upstream request handlers
+// while the broker is unavailable.
+func (hm *HealthMonitor) OnStateChange(callback func(connectionID uint64, status HealthStatus)) {
+	hm.callbackLock.Lock()
+	defer hm.callbackLock.Unlock()
+	hm.onStateChange = callback
+}
+
+// Status returns the last known HealthStatus for a connection, Healthy if never observed.
+func (hm *HealthMonitor) Status(connectionID uint64) HealthStatus {
+	hm.statusLock.RLock()
+	defer hm.statusLock.RUnlock()
+
+	if status, ok := hm.statuses[connectionID]; ok {
+		return status
+	}
+	return Healthy
+}
+
+// Watch subscribes to channelHost's NotifyClose and NotifyBlocked. A close rebuilds the channel
+// in the background; a blocked/unblocked notification only sets the connection's status to
+// Blocked or Healthy. ChannelPool.createChannelHost calls this automatically
+// for every channel it creates once StartHealthMonitor has been called.
+func (hm *HealthMonitor) Watch(channelHost *models.ChannelHost, ackable bool) {
+
+	closeErrors := channelHost.Channel.NotifyClose(make(chan *amqp.Error, 1))
+	blocked := channelHost.Connection.NotifyBlocked(make(chan amqp.Blocking, 1))
+
+	go hm.watch(channelHost.ChannelID, channelHost.ConnectionID, ackable, closeErrors, blocked)
+}
+
+func (hm *HealthMonitor) watch(
+	channelID uint64,
+	connectionID uint64,
+	ackable bool,
+	closeErrors chan *amqp.Error,
+	blocked chan amqp.Blocking) {
+
+	for {
+		select {
+		case _, ok := <-closeErrors:
+			if !ok {
+				return // channel was closed deliberately (e.g. pool Shutdown), nothing to rebuild
+			}
+
+			hm.setStatus(connectionID, Reconnecting)
+			hm.pool.FlagChannel(channelID)
+			hm.rebuild(channelID, connectionID, ackable)
+			return
+
+		case blocking, ok := <-blocked:
+			if !ok {
+				return
+			}
+			if blocking.Active {
+				hm.setStatus(connectionID, Blocked)
+			} else {
+				hm.setStatus(connectionID, Healthy)
+			}
+
+		case <-hm.stop:
+			return
+		}
+	}
+}
+
+// rebuild recreates channelID with jittered exponential backoff between failed attempts.
+// createChannelHost re-registers the replacement with Watch on success, so this goroutine's
+// job ends there - but the replacement host itself still has to go back into the pool's free
+// queue, or GetChannel/GetAckableChannel would eventually drain it to zero and block forever.
+func (hm *HealthMonitor) rebuild(channelID uint64, connectionID uint64, ackable bool) {
+
+	attempts := 0
+	for {
+		select {
+		case <-hm.stop:
+			return
+		default:
+		}
+
+		channelHost, err := hm.pool.createChannelHost(channelID, ackable)
+		if err == nil {
+			hm.pool.UnflagChannel(channelID)
+			hm.pool.ReturnChannel(channelHost)
+			hm.setStatus(channelHost.ConnectionID, Healthy)
+			return
+		}
+
+		hm.setStatus(connectionID, Failed)
+		time.Sleep(hm.backoff(attempts))
+		attempts++
+	}
+}
+
+// backoff computes base * 2^attempts, capped at MaxBackoff, with +/-25% jitter so a batch of
+// channels failing together doesn't all retry in lockstep.
+func (hm *HealthMonitor) backoff(attempts int) time.Duration {
+
+	scaled := float64(hm.config.BaseBackoff) * math.Pow(2, float64(attempts))
+	if max := float64(hm.config.MaxBackoff); scaled > max {
+		scaled = max
+	}
+
+	jitter := scaled * 0.25 * (2*rand.Float64() - 1)
+	return time.Duration(scaled + jitter)
+}
+
+func (hm *HealthMonitor) setStatus(connectionID uint64, status HealthStatus) {
+	hm.statusLock.Lock()
+	hm.statuses[connectionID] = status
+	hm.statusLock.Unlock()
+
+	hm.callbackLock.Lock()
+	callback := hm.onStateChange
+	hm.callbackLock.Unlock()
+
+	if callback != nil {
+		callback(connectionID, status)
+	}
+}
+
+// Stop ends all in-flight watches and backoff retries. The ChannelPool keeps working
+// afterward - it just goes back to GetChannel/GetAckableChannel's inline retry on the next
+// flagged channel.
+func (hm *HealthMonitor) Stop() {
+	close(hm.stop)
+}
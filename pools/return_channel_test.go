@@ -0,0 +1,86 @@
+package pools
+
+import (
+	"testing"
+
+	"github.com/Workiva/go-datastructures/queue"
+
+	"github.com/houseofcat/turbocookedrabbit/models"
+)
+
+// TestReturnChannelRequeuesByAckability exercises the requeue step the chunk0-6 fix added to
+// rebuild: ReturnChannel must land an ackable ChannelHost in ackChannels and a non-ackable one
+// in channels, so a rebuilt channel re-enters the pool's free queue instead of being orphaned.
+func TestReturnChannelRequeuesByAckability(t *testing.T) {
+	cp := &ChannelPool{
+		channels:    queue.New(1),
+		ackChannels: queue.New(1),
+	}
+
+	ackableHost, err := models.NewChannelHost(nil, 1, 1, true)
+	if err != nil {
+		t.Fatalf("NewChannelHost(ackable) error: %v", err)
+	}
+	cp.ReturnChannel(ackableHost)
+
+	if got := cp.AckChannelCount(); got != 1 {
+		t.Fatalf("AckChannelCount() = %d, want 1 after returning an ackable channel", got)
+	}
+	if got := cp.ChannelCount(); got != 0 {
+		t.Fatalf("ChannelCount() = %d, want 0 after returning an ackable channel", got)
+	}
+
+	plainHost, err := models.NewChannelHost(nil, 2, 1, false)
+	if err != nil {
+		t.Fatalf("NewChannelHost(non-ackable) error: %v", err)
+	}
+	cp.ReturnChannel(plainHost)
+
+	if got := cp.ChannelCount(); got != 1 {
+		t.Fatalf("ChannelCount() = %d, want 1 after returning a non-ackable channel", got)
+	}
+}
+
+// TestReturnChannelDropsStaleDuplicateChannelID guards the chunk0-6 rebuild/requeue fix: when a
+// dead ChannelHost is still sitting in the queue (the common case - it died while idle, so
+// nothing ever dequeued it) and the health monitor hands back a rebuilt replacement for the same
+// ChannelID, ReturnChannel must drop the stale entry instead of net-adding a second one.
+func TestReturnChannelDropsStaleDuplicateChannelID(t *testing.T) {
+	cp := &ChannelPool{
+		channels:    queue.New(2),
+		ackChannels: queue.New(2),
+	}
+
+	deadHost, err := models.NewChannelHost(nil, 5, 1, false)
+	if err != nil {
+		t.Fatalf("NewChannelHost(dead) error: %v", err)
+	}
+	cp.ReturnChannel(deadHost)
+
+	otherHost, err := models.NewChannelHost(nil, 6, 1, false)
+	if err != nil {
+		t.Fatalf("NewChannelHost(other) error: %v", err)
+	}
+	cp.ReturnChannel(otherHost)
+
+	rebuiltHost, err := models.NewChannelHost(nil, 5, 1, false)
+	if err != nil {
+		t.Fatalf("NewChannelHost(rebuilt) error: %v", err)
+	}
+	cp.ReturnChannel(rebuiltHost)
+
+	if got := cp.ChannelCount(); got != 2 {
+		t.Fatalf("ChannelCount() = %d, want 2 (stale ChannelID 5 dropped, rebuilt 5 and 6 remain)", got)
+	}
+
+	structs, err := cp.channels.Get(2)
+	if err != nil {
+		t.Fatalf("channels.Get(2) error: %v", err)
+	}
+	for _, s := range structs {
+		host := s.(*models.ChannelHost)
+		if host.ChannelID == 5 && host != rebuiltHost {
+			t.Fatal("ChannelID 5 in queue is the stale dead host, not the rebuilt replacement")
+		}
+	}
+}
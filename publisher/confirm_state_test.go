@@ -0,0 +1,72 @@
+package publisher
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/houseofcat/turbocookedrabbit/pools"
+)
+
+// TestChannelConfirmStateNextDeliveryTagIsMonotonic guards against the chunk0-1 bug: the
+// delivery tag must keep climbing for the lifetime of the channelConfirmState instead of
+// restarting at 1 on every publishBatchOnChannel call.
+func TestChannelConfirmStateNextDeliveryTagIsMonotonic(t *testing.T) {
+	state := &channelConfirmState{}
+
+	for want := uint64(1); want <= 5; want++ {
+		if got := state.nextDeliveryTag(); got != want {
+			t.Fatalf("nextDeliveryTag() = %d, want %d", got, want)
+		}
+	}
+}
+
+// TestChannelConfirmStateNextDeliveryTagIsUniqueUnderConcurrency checks that concurrent
+// publishes on the same channel (e.g. a Batched autopublish worker draining several letters at
+// once) never hand out the same delivery tag twice.
+func TestChannelConfirmStateNextDeliveryTagIsUniqueUnderConcurrency(t *testing.T) {
+	state := &channelConfirmState{}
+
+	const workers = 50
+	tags := make(chan uint64, workers)
+
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer wg.Done()
+			tags <- state.nextDeliveryTag()
+		}()
+	}
+	wg.Wait()
+	close(tags)
+
+	seen := make(map[uint64]bool, workers)
+	for tag := range tags {
+		if seen[tag] {
+			t.Fatalf("duplicate delivery tag %d handed out", tag)
+		}
+		seen[tag] = true
+	}
+	if len(seen) != workers {
+		t.Fatalf("got %d unique tags, want %d", len(seen), workers)
+	}
+}
+
+// TestConfirmStateReturnsSameInstanceForSameChannel guards the other half of the chunk0-1 fix:
+// two calls against the same physical channel must share one channelConfirmState rather than
+// publishBatchOnChannel (or simplePublish) starting over from a fresh counter each time.
+func TestConfirmStateReturnsSameInstanceForSameChannel(t *testing.T) {
+	pub := &AMQPPublisher{confirmStates: &sync.Map{}}
+	chanHost := &pools.ChannelHost{}
+
+	first := pub.confirmState(chanHost)
+	first.nextDeliveryTag()
+
+	second := pub.confirmState(chanHost)
+	if second != first {
+		t.Fatal("confirmState returned a new state for the same channel - delivery tag would reset per call")
+	}
+	if second.deliveryTag != 1 {
+		t.Fatalf("deliveryTag = %d, want 1 (state must persist across confirmState calls on the same channel)", second.deliveryTag)
+	}
+}
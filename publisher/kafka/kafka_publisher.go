@@ -0,0 +1,183 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/houseofcat/turbocookedrabbit/models"
+	"github.com/houseofcat/turbocookedrabbit/publisher"
+
+	kafkago "github.com/segmentio/kafka-go"
+)
+
+// KafkaPublisher is the Kafka-backed Publisher implementation. It maps Envelope.Topic onto
+// the Kafka topic and Envelope.PartitionKey onto the message key, so ordering guarantees
+// follow whatever partitioning the caller already relies on.
+type KafkaPublisher struct {
+	Config           *models.RabbitSeasoning
+	writer           *kafkago.Writer
+	errors           chan error
+	letters          chan *models.Letter
+	autoStop         chan bool
+	publishReceipts  chan *models.PublishReceipt
+	autoStarted      bool
+	autoPublishGroup *sync.WaitGroup
+	pubLock          *sync.Mutex
+}
+
+var _ publisher.Publisher = (*KafkaPublisher)(nil)
+
+// NewKafkaPublisher creates and configures a new KafkaPublisher.
+func NewKafkaPublisher(config *models.RabbitSeasoning) (*KafkaPublisher, error) {
+
+	if config.BrokerType != models.KafkaBroker {
+		return nil, fmt.Errorf("can't create a KafkaPublisher - RabbitSeasoning.BrokerType is %v, not KafkaBroker", config.BrokerType)
+	}
+
+	writer := &kafkago.Writer{
+		Addr:         kafkago.TCP(config.KafkaConfig.Brokers...),
+		Balancer:     &kafkago.Hash{},
+		RequiredAcks: kafkago.RequireAll, // WriteMessages waits for the broker to ack each write
+	}
+
+	return &KafkaPublisher{
+		Config:           config,
+		writer:           writer,
+		errors:           make(chan error),
+		letters:          make(chan *models.Letter),
+		autoStop:         make(chan bool, 1),
+		publishReceipts:  make(chan *models.PublishReceipt),
+		autoPublishGroup: &sync.WaitGroup{},
+		pubLock:          &sync.Mutex{},
+	}, nil
+}
+
+// Publish sends a single message to the topic on the letter.
+// Subscribe to PublishReceipts to see success and errors.
+func (pub *KafkaPublisher) Publish(letter *models.Letter) {
+	err := pub.writer.WriteMessages(context.Background(), kafkago.Message{
+		Topic: letter.Envelope.Topic,
+		Key:   []byte(letter.Envelope.PartitionKey),
+		Value: letter.Body,
+	})
+	pub.publishReceipt(letter, err)
+}
+
+// PublishWithConfirmation sends a single message to the topic on the letter.
+// WriteMessages already blocks until the broker acks, so this only adds the timeout bound.
+func (pub *KafkaPublisher) PublishWithConfirmation(letter *models.Letter, timeout time.Duration) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	err := pub.writer.WriteMessages(ctx, kafkago.Message{
+		Topic: letter.Envelope.Topic,
+		Key:   []byte(letter.Envelope.PartitionKey),
+		Value: letter.Body,
+	})
+	pub.publishReceipt(letter, err)
+}
+
+// QueueLetter queues up a letter that will be consumed by auto-publishing.
+// Blocks on the Letter buffer being full. Call StartAutoPublishing first, or queued letters
+// have nothing draining them and this blocks forever.
+func (pub *KafkaPublisher) QueueLetter(letter *models.Letter) {
+	pub.letters <- letter
+}
+
+// StartAutoPublishing starts draining letters queued via QueueLetter and publishing them.
+func (pub *KafkaPublisher) StartAutoPublishing() {
+	pub.pubLock.Lock()
+	defer pub.pubLock.Unlock()
+
+	if !pub.autoStarted {
+		pub.FlushStops()
+
+		pub.autoStarted = true
+		pub.autoPublishGroup.Add(1)
+		go pub.autoPublishWorker()
+	}
+}
+
+// StopAutoPublish stops publishing letters queued up.
+func (pub *KafkaPublisher) StopAutoPublish() {
+	pub.pubLock.Lock()
+	defer pub.pubLock.Unlock()
+
+	if !pub.autoStarted {
+		return
+	}
+
+	go func() { pub.autoStop <- true }() // signal auto publish to stop
+}
+
+// FlushStops flushes out all the AutoStop messages.
+func (pub *KafkaPublisher) FlushStops() {
+
+FlushLoop:
+	for {
+		select {
+		case <-pub.autoStop:
+		default:
+			break FlushLoop
+		}
+	}
+}
+
+// autoPublishWorker drains letters queued via QueueLetter and publishes them one at a time
+// until StopAutoPublish signals it to stop.
+func (pub *KafkaPublisher) autoPublishWorker() {
+	defer pub.autoPublishGroup.Done()
+
+	for {
+		select {
+		case letter := <-pub.letters:
+			pub.Publish(letter)
+		case <-pub.autoStop:
+			pub.pubLock.Lock()
+			pub.autoStarted = false
+			pub.pubLock.Unlock()
+			return
+		}
+	}
+}
+
+// PublishReceipts yields all the success and failures during all publish events. Highly recommend susbscribing to this.
+func (pub *KafkaPublisher) PublishReceipts() <-chan *models.PublishReceipt {
+	return pub.publishReceipts
+}
+
+// Errors yields all the internal errs for delivering letters.
+func (pub *KafkaPublisher) Errors() <-chan error {
+	return pub.errors
+}
+
+// Shutdown cleanly shutsdown the publisher and closes the underlying Kafka writer.
+func (pub *KafkaPublisher) Shutdown(shutdownPools bool) {
+	pub.pubLock.Lock()
+	defer pub.pubLock.Unlock()
+
+	if pub.autoStarted {
+		go func() { pub.autoStop <- true }()
+	}
+
+	pub.writer.Close()
+}
+
+// publishReceipt sends the status to the receipt channel.
+func (pub *KafkaPublisher) publishReceipt(letter *models.Letter, err error) {
+
+	publishReceipt := &models.PublishReceipt{
+		LetterID: letter.LetterID,
+		Error:    err,
+	}
+
+	if err == nil {
+		publishReceipt.Success = true
+	} else {
+		publishReceipt.FailedLetter = letter
+	}
+
+	pub.publishReceipts <- publishReceipt
+}
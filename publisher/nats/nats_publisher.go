@@ -0,0 +1,173 @@
+package nats
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/houseofcat/turbocookedrabbit/models"
+	"github.com/houseofcat/turbocookedrabbit/publisher"
+
+	natsgo "github.com/nats-io/nats.go"
+)
+
+// NATSPublisher is the NATS-backed Publisher implementation. NATS has no exchange/routing-key
+// split, so it maps Envelope.Subject directly onto a NATS subject.
+type NATSPublisher struct {
+	Config           *models.RabbitSeasoning
+	conn             *natsgo.Conn
+	errors           chan error
+	letters          chan *models.Letter
+	autoStop         chan bool
+	publishReceipts  chan *models.PublishReceipt
+	autoStarted      bool
+	autoPublishGroup *sync.WaitGroup
+	pubLock          *sync.Mutex
+}
+
+var _ publisher.Publisher = (*NATSPublisher)(nil)
+
+// NewNATSPublisher creates and connects a new NATSPublisher.
+func NewNATSPublisher(config *models.RabbitSeasoning) (*NATSPublisher, error) {
+
+	if config.BrokerType != models.NATSBroker {
+		return nil, fmt.Errorf("can't create a NATSPublisher - RabbitSeasoning.BrokerType is %v, not NATSBroker", config.BrokerType)
+	}
+
+	conn, err := natsgo.Connect(config.NATSConfig.URI)
+	if err != nil {
+		return nil, err
+	}
+
+	return &NATSPublisher{
+		Config:           config,
+		conn:             conn,
+		errors:           make(chan error),
+		letters:          make(chan *models.Letter),
+		autoStop:         make(chan bool, 1),
+		publishReceipts:  make(chan *models.PublishReceipt),
+		autoPublishGroup: &sync.WaitGroup{},
+		pubLock:          &sync.Mutex{},
+	}, nil
+}
+
+// Publish sends a single message to the subject on the letter.
+// Subscribe to PublishReceipts to see success and errors.
+func (pub *NATSPublisher) Publish(letter *models.Letter) {
+	err := pub.conn.Publish(letter.Envelope.Subject, letter.Body)
+	pub.publishReceipt(letter, err)
+}
+
+// PublishWithConfirmation sends a single message to the subject on the letter and flushes the
+// connection, NATS's closest analogue to an AMQP publish confirm.
+// A timeout failure drops the letter back in the PublishReceipts.
+func (pub *NATSPublisher) PublishWithConfirmation(letter *models.Letter, timeout time.Duration) {
+	err := pub.conn.Publish(letter.Envelope.Subject, letter.Body)
+	if err == nil {
+		err = pub.conn.FlushTimeout(timeout)
+	}
+	pub.publishReceipt(letter, err)
+}
+
+// QueueLetter queues up a letter that will be consumed by auto-publishing.
+// Blocks on the Letter buffer being full. Call StartAutoPublishing first, or queued letters
+// have nothing draining them and this blocks forever.
+func (pub *NATSPublisher) QueueLetter(letter *models.Letter) {
+	pub.letters <- letter
+}
+
+// StartAutoPublishing starts draining letters queued via QueueLetter and publishing them.
+func (pub *NATSPublisher) StartAutoPublishing() {
+	pub.pubLock.Lock()
+	defer pub.pubLock.Unlock()
+
+	if !pub.autoStarted {
+		pub.FlushStops()
+
+		pub.autoStarted = true
+		pub.autoPublishGroup.Add(1)
+		go pub.autoPublishWorker()
+	}
+}
+
+// StopAutoPublish stops publishing letters queued up.
+func (pub *NATSPublisher) StopAutoPublish() {
+	pub.pubLock.Lock()
+	defer pub.pubLock.Unlock()
+
+	if !pub.autoStarted {
+		return
+	}
+
+	go func() { pub.autoStop <- true }() // signal auto publish to stop
+}
+
+// FlushStops flushes out all the AutoStop messages.
+func (pub *NATSPublisher) FlushStops() {
+
+FlushLoop:
+	for {
+		select {
+		case <-pub.autoStop:
+		default:
+			break FlushLoop
+		}
+	}
+}
+
+// autoPublishWorker drains letters queued via QueueLetter and publishes them one at a time
+// until StopAutoPublish signals it to stop.
+func (pub *NATSPublisher) autoPublishWorker() {
+	defer pub.autoPublishGroup.Done()
+
+	for {
+		select {
+		case letter := <-pub.letters:
+			pub.Publish(letter)
+		case <-pub.autoStop:
+			pub.pubLock.Lock()
+			pub.autoStarted = false
+			pub.pubLock.Unlock()
+			return
+		}
+	}
+}
+
+// PublishReceipts yields all the success and failures during all publish events. Highly recommend susbscribing to this.
+func (pub *NATSPublisher) PublishReceipts() <-chan *models.PublishReceipt {
+	return pub.publishReceipts
+}
+
+// Errors yields all the internal errs for delivering letters.
+func (pub *NATSPublisher) Errors() <-chan error {
+	return pub.errors
+}
+
+// Shutdown cleanly shutsdown the publisher and closes the underlying NATS connection.
+func (pub *NATSPublisher) Shutdown(shutdownPools bool) {
+	pub.pubLock.Lock()
+	defer pub.pubLock.Unlock()
+
+	if pub.autoStarted {
+		go func() { pub.autoStop <- true }()
+	}
+
+	pub.conn.Close()
+}
+
+// publishReceipt sends the status to the receipt channel.
+func (pub *NATSPublisher) publishReceipt(letter *models.Letter, err error) {
+
+	publishReceipt := &models.PublishReceipt{
+		LetterID: letter.LetterID,
+		Error:    err,
+	}
+
+	if err == nil {
+		publishReceipt.Success = true
+	} else {
+		publishReceipt.FailedLetter = letter
+	}
+
+	pub.publishReceipts <- publishReceipt
+}
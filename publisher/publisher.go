@@ -3,6 +3,7 @@ package publisher
 import (
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/houseofcat/turbocookedrabbit/models"
@@ -11,14 +12,61 @@ import (
 	"github.com/streadway/amqp"
 )
 
-// Publisher contains everything you need to publish a message.
-type Publisher struct {
+// LetterBufferFullPolicy controls what QueueLetter/QueueLetters do when the letter buffer
+// is already at PublisherConfig.LetterBufferSize capacity. TryQueueLetter ignores this and
+// always behaves like DropNewest, since its entire point is to never block the caller.
+type LetterBufferFullPolicy int
+
+const (
+	// Block waits for room in the buffer, the same behavior as the old unbuffered channel.
+	Block LetterBufferFullPolicy = iota
+	// DropOldest evicts the oldest buffered letter to make room for the incoming one.
+	DropOldest
+	// DropNewest discards the incoming letter, leaving the buffer untouched.
+	DropNewest
+	// RejectWithReceipt discards the incoming letter and emits a failed PublishReceipt for it.
+	RejectWithReceipt
+)
+
+// PublishStrategy picks the delivery guarantee every autopublish worker applies uniformly.
+type PublishStrategy int
+
+const (
+	// Simple fires the letter and moves on - no delivery confirmation.
+	Simple PublishStrategy = iota
+	// Confirmed pipelines the letter through the same publish-confirms machinery as
+	// PublishBatchWithConfirmation, waiting for the broker to ack before the worker moves on.
+	Confirmed
+	// Batched drains whatever else is already queued alongside the letter and publishes the
+	// whole group through the pipelined-confirms machinery in one batch.
+	Batched
+)
+
+// Publisher is the broker-neutral surface every backend implements, so callers can depend
+// on Publish/queueing/receipt semantics without caring whether letters end up on RabbitMQ,
+// NATS, or Kafka. The backend is picked by which constructor is called - AMQPPublisher here,
+// and publisher/nats.NATSPublisher and publisher/kafka.KafkaPublisher for the sibling
+// implementations. Each constructor checks RabbitSeasoning.BrokerType and refuses a config for
+// another broker; nothing in this package chooses the backend from BrokerType.
+type Publisher interface {
+	Publish(letter *models.Letter)
+	PublishWithConfirmation(letter *models.Letter, timeout time.Duration)
+	QueueLetter(letter *models.Letter)
+	PublishReceipts() <-chan *models.PublishReceipt
+	Errors() <-chan error
+	Shutdown(shutdownPools bool)
+}
+
+// AMQPPublisher is the RabbitMQ-backed Publisher implementation. It contains everything
+// you need to publish a message over AMQP.
+type AMQPPublisher struct {
 	Config                   *models.RabbitSeasoning
 	ConnectionPool           *pools.ConnectionPool
 	errors                   chan error
 	letters                  chan *models.Letter
 	autoStop                 chan bool
 	publishReceipts          chan *models.PublishReceipt
+	returns                  chan *models.ReturnedLetter
 	autoStarted              bool
 	autoPublishGroup         *sync.WaitGroup
 	sleepOnIdleInterval      time.Duration
@@ -26,60 +74,176 @@ type Publisher struct {
 	sleepOnErrorInterval     time.Duration
 	pubLock                  *sync.Mutex
 	pubRWLock                *sync.RWMutex
+	confirmStates            *sync.Map // *amqp.Channel -> *channelConfirmState
 }
 
-// NewPublisherWithConfig creates and configures a new Publisher.
-func NewPublisherWithConfig(
+var _ Publisher = (*AMQPPublisher)(nil)
+
+// NewAMQPPublisherWithConfig creates and configures a new AMQPPublisher.
+func NewAMQPPublisherWithConfig(
 	config *models.RabbitSeasoning,
-	cp *pools.ConnectionPool) (*Publisher, error) {
+	cp *pools.ConnectionPool) (*AMQPPublisher, error) {
 
-	return &Publisher{
-		Config:               config,
-		ConnectionPool:       cp,
-		errors:               make(chan error),
-		letters:              make(chan *models.Letter),
-		autoStop:             make(chan bool, 1),
-		autoPublishGroup:     &sync.WaitGroup{},
-		publishReceipts:      make(chan *models.PublishReceipt),
-		sleepOnIdleInterval:  time.Duration(config.PublisherConfig.SleepOnIdleInterval) * time.Millisecond,
-		sleepOnErrorInterval: time.Duration(config.PublisherConfig.SleepOnErrorInterval) * time.Millisecond,
-		pubLock:              &sync.Mutex{},
-		pubRWLock:            &sync.RWMutex{},
-		autoStarted:          false,
+	if config.BrokerType != "" && config.BrokerType != models.AMQPBroker {
+		return nil, fmt.Errorf("can't create an AMQPPublisher - RabbitSeasoning.BrokerType is %v, not AMQPBroker", config.BrokerType)
+	}
+
+	return &AMQPPublisher{
+		Config:                   config,
+		ConnectionPool:           cp,
+		errors:                   make(chan error),
+		letters:                  make(chan *models.Letter, config.PublisherConfig.LetterBufferSize),
+		autoStop:                 make(chan bool, 1),
+		autoPublishGroup:         &sync.WaitGroup{},
+		publishReceipts:          make(chan *models.PublishReceipt),
+		returns:                  make(chan *models.ReturnedLetter),
+		sleepOnIdleInterval:      time.Duration(config.PublisherConfig.SleepOnIdleInterval) * time.Millisecond,
+		sleepOnQueueFullInterval: time.Duration(config.PublisherConfig.SleepOnQueueFullInterval) * time.Millisecond,
+		sleepOnErrorInterval:     time.Duration(config.PublisherConfig.SleepOnErrorInterval) * time.Millisecond,
+		pubLock:                  &sync.Mutex{},
+		pubRWLock:                &sync.RWMutex{},
+		confirmStates:            &sync.Map{},
+		autoStarted:              false,
 	}, nil
 }
 
-// NewPublisher creates and configures a new Publisher.
-func NewPublisher(
+// NewAMQPPublisher creates and configures a new AMQPPublisher.
+func NewAMQPPublisher(
 	cp *pools.ConnectionPool,
 	sleepOnIdleInterval time.Duration,
-	sleepOnErrorInterval time.Duration) (*Publisher, error) {
+	sleepOnErrorInterval time.Duration) (*AMQPPublisher, error) {
 
-	return &Publisher{
+	return &AMQPPublisher{
 		ConnectionPool:       cp,
 		letters:              make(chan *models.Letter),
 		autoStop:             make(chan bool, 1),
 		autoPublishGroup:     &sync.WaitGroup{},
 		publishReceipts:      make(chan *models.PublishReceipt),
+		returns:              make(chan *models.ReturnedLetter),
 		sleepOnIdleInterval:  sleepOnIdleInterval,
 		sleepOnErrorInterval: sleepOnErrorInterval,
 		pubLock:              &sync.Mutex{},
 		pubRWLock:            &sync.RWMutex{},
+		confirmStates:        &sync.Map{},
 		autoStarted:          false,
 	}, nil
 }
 
+// channelConfirmState is the confirm-mode bookkeeping that must persist for a physical
+// channel's entire lifetime rather than a single publish/batch call: streadway/amqp delivery
+// tags are a monotonic per-channel sequence that only resets when the channel itself is
+// recreated, and Channel.Confirm/NotifyPublish/NotifyReturn must each be wired up exactly once
+// per channel - amqp has no way to unregister a listener, so re-registering a throwaway one on
+// every call leaves abandoned listeners registered forever, and the third return delivered to
+// an abandoned, full listener blocks amqp's internal dispatch loop for the whole channel.
+type channelConfirmState struct {
+	deliveryTag    uint64
+	confirmOnce    sync.Once
+	returns        chan amqp.Return
+	returnsOnce    sync.Once
+	mandatoryLock  sync.Mutex
+	mandatoryQueue []uint64 // delivery tags of mandatory publishes still awaiting an ack/nack or a Return, oldest first
+}
+
+// ensureConfirmMode puts chanHost's channel into confirm mode, wires up NotifyPublish, and
+// registers the NotifyReturn listener the first time it's called for this physical channel;
+// later calls are no-ops. Every confirmed publish needs both: a mandatory message the broker
+// can't route is still acked (acks confirm receipt, not routability), so Returns have to be
+// correlated against the pending delivery tags instead of being treated as a success.
+func (cs *channelConfirmState) ensureConfirmMode(chanHost *pools.ChannelHost) {
+	cs.confirmOnce.Do(func() {
+		chanHost.Channel.Confirm(false)
+		chanHost.Channel.NotifyPublish(chanHost.Confirmations)
+	})
+	cs.returnsChan(chanHost.Channel)
+}
+
+// returnsChan registers channel's NotifyReturn listener the first time it's called for this
+// physical channel and returns it; later calls return the same channel without re-registering,
+// so callers must keep draining it for the channel's entire lifetime.
+func (cs *channelConfirmState) returnsChan(channel *amqp.Channel) chan amqp.Return {
+	cs.returnsOnce.Do(func() {
+		cs.returns = make(chan amqp.Return, 1)
+		channel.NotifyReturn(cs.returns)
+	})
+	return cs.returns
+}
+
+// nextDeliveryTag hands out the next delivery tag in this channel's monotonic confirm sequence.
+func (cs *channelConfirmState) nextDeliveryTag() uint64 {
+	return atomic.AddUint64(&cs.deliveryTag, 1)
+}
+
+// trackMandatory records tag as a mandatory publish still awaiting an outcome. amqp.Return
+// carries no delivery tag, but the broker delivers Returns in the same order as the mandatory
+// publishes that produced them, so a Return always resolves the oldest tag in this queue.
+func (cs *channelConfirmState) trackMandatory(tag uint64) {
+	cs.mandatoryLock.Lock()
+	cs.mandatoryQueue = append(cs.mandatoryQueue, tag)
+	cs.mandatoryLock.Unlock()
+}
+
+// resolveMandatory drops tag from the mandatory queue because its ack/nack arrived first, so a
+// later Return on this channel doesn't mistake some other, younger letter for this one.
+func (cs *channelConfirmState) resolveMandatory(tag uint64) {
+	cs.mandatoryLock.Lock()
+	defer cs.mandatoryLock.Unlock()
+	for i, pending := range cs.mandatoryQueue {
+		if pending == tag {
+			cs.mandatoryQueue = append(cs.mandatoryQueue[:i], cs.mandatoryQueue[i+1:]...)
+			return
+		}
+	}
+}
+
+// nextMandatoryReturn pops the oldest still-pending mandatory tag, which is the tag a
+// just-arrived Return belongs to.
+func (cs *channelConfirmState) nextMandatoryReturn() (uint64, bool) {
+	cs.mandatoryLock.Lock()
+	defer cs.mandatoryLock.Unlock()
+	if len(cs.mandatoryQueue) == 0 {
+		return 0, false
+	}
+	tag := cs.mandatoryQueue[0]
+	cs.mandatoryQueue = cs.mandatoryQueue[1:]
+	return tag, true
+}
+
+// confirmState returns the channelConfirmState for chanHost's physical channel, creating it the
+// first time the channel is seen. Keyed by the *amqp.Channel itself (not chanHost.ChannelID,
+// which the pool reuses across reconnects) so a rebuilt channel always starts from fresh state.
+func (pub *AMQPPublisher) confirmState(chanHost *pools.ChannelHost) *channelConfirmState {
+	value, _ := pub.confirmStates.LoadOrStore(chanHost.Channel, &channelConfirmState{})
+	return value.(*channelConfirmState)
+}
+
 // Publish sends a single message to the address on the letter.
 // Subscribe to PublishReceipts to see success and errors.
 // For proper resilience (at least once delivery guarantee over shaky network) use PublishWithConfirmation
-func (pub *Publisher) Publish(letter *models.Letter) {
+func (pub *AMQPPublisher) Publish(letter *models.Letter) {
 
 	chanHost := pub.ConnectionPool.GetChannel(!pub.Config.PublisherConfig.AutoAck)
+	defer chanHost.Close()
 
 	pub.simplePublish(chanHost, letter)
 }
 
-func (pub *Publisher) simplePublish(chanHost *pools.ChannelHost, letter *models.Letter) {
+// simplePublish fires the letter on chanHost. It never closes chanHost - callers that own a
+// single-use channel (Publish) should close it themselves, while long-lived owners (an
+// autopublish worker) hold onto it across many letters.
+//
+// A mandatory letter is routed through publishBatchOnChannel instead of a bare Channel.Publish:
+// the broker acks a mandatory message whether or not it could route it (an ack confirms receipt,
+// not routability), so the only way to tell an unroutable letter from a routed one is to put the
+// channel in confirm mode and correlate its Return against the pending delivery tag - exactly
+// what publishBatchOnChannel already does for a batch. There's no shortcut that doesn't risk
+// racing an arbitrary timeout against a Return that's still in flight.
+func (pub *AMQPPublisher) simplePublish(chanHost *pools.ChannelHost, letter *models.Letter) {
+
+	if letter.Envelope.Mandatory {
+		pub.publishBatchOnChannel(chanHost, []*models.Letter{letter}, pub.publishConfirmTimeout())
+		return
+	}
 
 	err := chanHost.Channel.Publish(
 		letter.Envelope.Exchange,
@@ -94,31 +258,29 @@ func (pub *Publisher) simplePublish(chanHost *pools.ChannelHost, letter *models.
 		},
 	)
 
-	chanHost.Close()
 	pub.publishReceipt(letter, err)
 }
 
-// PublishWithConfirmation sends a single message to the address on the letter with confirmation capabilities.
-// This is an expensive and slow call - use this when delivery confirmation on publish is your highest priority.
-// A timeout failure drops the letter back in the PublishReceipts.
-// A confirmation failure keeps trying to publish (at least until timeout failure occurs.)
-func (pub *Publisher) PublishWithConfirmation(letter *models.Letter, timeout time.Duration) {
-
-	timeoutAfter := time.After(timeout)
-
-GetChannelAndPublish:
-	for {
-		// Has to use an Ackable channel for Publish Confirmations.
-		chanHost := pub.ConnectionPool.GetChannel(true)
-
-		// Subscribe to publish confirmations
-		chanHost.Channel.NotifyPublish(chanHost.Confirmations)
+// handleReturn reports a mandatory letter the broker couldn't route on the Returns channel and,
+// when PublisherConfig.RepublishUnroutable is enabled, republishes it to the DeadLetterExchange
+// so it isn't silently dropped.
+func (pub *AMQPPublisher) handleReturn(chanHost *pools.ChannelHost, letter *models.Letter, ret amqp.Return) {
+
+	go func() {
+		pub.returns <- &models.ReturnedLetter{
+			Letter:     letter,
+			ReplyCode:  ret.ReplyCode,
+			ReplyText:  ret.ReplyText,
+			Exchange:   ret.Exchange,
+			RoutingKey: ret.RoutingKey,
+		}
+	}()
 
-	Publish:
-		err := chanHost.Channel.Publish(
-			letter.Envelope.Exchange,
+	if pub.Config.PublisherConfig.RepublishUnroutable && pub.Config.PublisherConfig.DeadLetterExchange != "" {
+		if err := chanHost.Channel.Publish(
+			pub.Config.PublisherConfig.DeadLetterExchange,
 			letter.Envelope.RoutingKey,
-			letter.Envelope.Mandatory,
+			false,
 			letter.Envelope.Immediate,
 			amqp.Publishing{
 				ContentType:  letter.Envelope.ContentType,
@@ -126,43 +288,209 @@ GetChannelAndPublish:
 				Headers:      amqp.Table(letter.Envelope.Headers),
 				DeliveryMode: letter.Envelope.DeliveryMode,
 			},
-		)
-		if err != nil {
-			chanHost.Close()
-			continue // Take it again! From the top!
+		); err != nil {
+			pub.errors <- fmt.Errorf("failed to republish unroutable LetterId: %d to dead letter exchange %q: %v", letter.LetterID, pub.Config.PublisherConfig.DeadLetterExchange, err)
 		}
+	}
 
-		// Wait for Publish Confirmations
-		for {
-			select {
-			case <-timeoutAfter:
-				pub.publishReceipt(letter, fmt.Errorf("publish confirmation for LetterId: %d wasn't received in a timely manner (300ms) - recommend manual retry", letter.LetterID))
-				break
+	pub.publishReceiptWithReturn(letter, fmt.Errorf("letterid: %d was returned unroutable [exchange: %s] [routingkey: %s] [reason: %s]", letter.LetterID, ret.Exchange, ret.RoutingKey, ret.ReplyText), true)
+}
+
+// PublishWithConfirmation sends a single message to the address on the letter with confirmation capabilities.
+// Use this when delivery confirmation on publish is your highest priority.
+// A timeout failure drops the letter back in the PublishReceipts.
+// A confirmation failure (nack) is retried automatically until it acks or times out.
+func (pub *AMQPPublisher) PublishWithConfirmation(letter *models.Letter, timeout time.Duration) {
+	pub.PublishBatchWithConfirmation([]*models.Letter{letter}, timeout)
+}
+
+// pendingPublish tracks a letter that has been published but not yet confirmed,
+// keyed by the delivery tag the channel assigned it.
+type pendingPublish struct {
+	letter *models.Letter
+	timer  *time.Timer
+}
+
+// PublishBatchWithConfirmation pipelines every letter in the batch onto a single Ackable channel
+// and correlates incoming amqp.Confirmations back to their originating letter by delivery tag,
+// instead of waiting for each publish to be acked before sending the next one.
+// Nacks re-enqueue only the offending letter; the timeout is tracked per-letter so one slow
+// ack can't stall the rest of the batch. A mandatory letter the broker can't route is still
+// acked (an ack confirms receipt, not routability), so its Return is correlated against the
+// oldest still-pending mandatory delivery tag and reported unroutable instead of a false
+// success. A PublishReceipt is emitted as each outcome arrives.
+func (pub *AMQPPublisher) PublishBatchWithConfirmation(letters []*models.Letter, timeout time.Duration) {
+	if len(letters) == 0 {
+		return
+	}
+
+	// Has to use an Ackable channel for Publish Confirmations.
+	chanHost := pub.ConnectionPool.GetChannel(true)
+	defer chanHost.Close()
+
+	pub.publishBatchOnChannel(chanHost, letters, timeout)
+}
+
+// publishBatchOnChannel is the channel-agnostic core of PublishBatchWithConfirmation: it pipelines
+// every letter in the batch over the given (already-Ackable) chanHost instead of acquiring its own,
+// so long-lived owners like an autopublish worker can reuse the same channel across batches.
+func (pub *AMQPPublisher) publishBatchOnChannel(chanHost *pools.ChannelHost, letters []*models.Letter, timeout time.Duration) {
+	if len(letters) == 0 {
+		return
+	}
+
+	state := pub.confirmState(chanHost)
+	state.ensureConfirmMode(chanHost)
+
+	queue := make(chan *models.Letter, len(letters))
+	for _, letter := range letters {
+		queue <- letter
+	}
+
+	pending := &sync.Map{} // deliveryTag (uint64) -> *pendingPublish
+	remaining := int64(len(letters))
+	allDone := make(chan struct{})
+	var closeOnce sync.Once
+
+	finish := func() {
+		if atomic.AddInt64(&remaining, -1) == 0 {
+			closeOnce.Do(func() { close(allDone) })
+		}
+	}
 
-			case confirmation := <-chanHost.Confirmations:
+	go pub.drainPublishConfirmations(chanHost, state, pending, queue, finish, allDone)
+
+PublishLoop:
+	for {
+		select {
+		case letter := <-queue:
+			tag := state.nextDeliveryTag()
+
+			timer := time.AfterFunc(timeout, func() {
+				if value, ok := pending.LoadAndDelete(tag); ok {
+					pp := value.(*pendingPublish)
+					state.resolveMandatory(tag)
+					pub.publishReceipt(pp.letter, fmt.Errorf("publish confirmation for LetterId: %d wasn't received within %s - recommend manual retry", pp.letter.LetterID, timeout))
+					finish()
+				}
+			})
+			pending.Store(tag, &pendingPublish{letter: letter, timer: timer})
+			if letter.Envelope.Mandatory {
+				state.trackMandatory(tag) // before Publish, so a fast Return always finds its tag
+			}
 
-				if !confirmation.Ack { // retry publish
-					goto Publish
+			err := chanHost.Channel.Publish(
+				letter.Envelope.Exchange,
+				letter.Envelope.RoutingKey,
+				letter.Envelope.Mandatory,
+				letter.Envelope.Immediate,
+				amqp.Publishing{
+					ContentType:  letter.Envelope.ContentType,
+					Body:         letter.Body,
+					Headers:      amqp.Table(letter.Envelope.Headers),
+					DeliveryMode: letter.Envelope.DeliveryMode,
+				},
+			)
+			if err != nil {
+				if value, ok := pending.LoadAndDelete(tag); ok {
+					value.(*pendingPublish).timer.Stop()
 				}
+				state.resolveMandatory(tag)
+				pub.publishReceipt(letter, err)
+				finish()
+			}
+
+		case <-allDone:
+			break PublishLoop
+		}
+	}
+}
 
-				pub.publishReceipt(letter, nil)
-				break GetChannelAndPublish
+// drainPublishConfirmations correlates amqp.Confirmations and Returns arriving on chanHost back
+// to the pending letter that produced them, acking, re-queueing, or reporting unroutable as
+// appropriate. A mandatory letter the broker can't route is still acked (an ack confirms
+// receipt, not routability), so every confirmation's tag is checked against state's mandatory
+// queue before being treated as a plain success.
+func (pub *AMQPPublisher) drainPublishConfirmations(
+	chanHost *pools.ChannelHost,
+	state *channelConfirmState,
+	pending *sync.Map,
+	queue chan *models.Letter,
+	finish func(),
+	allDone chan struct{}) {
+
+	returns := state.returnsChan(chanHost.Channel)
 
+	for {
+		select {
+		case confirmation := <-chanHost.Confirmations:
+			// The broker sends a mandatory letter's Return before its ack, so a Return already
+			// waiting is resolved first; otherwise this ack could be taken for a routed success.
+			select {
+			case ret := <-returns:
+				if tag, ok := state.nextMandatoryReturn(); ok {
+					if value, ok := pending.LoadAndDelete(tag); ok {
+						pp := value.(*pendingPublish)
+						pp.timer.Stop()
+						pub.handleReturn(chanHost, pp.letter, ret)
+						finish()
+					}
+				}
 			default:
+			}
 
-				time.Sleep(time.Duration(time.Millisecond * 3))
+			value, ok := pending.LoadAndDelete(confirmation.DeliveryTag)
+			if !ok {
+				continue // already timed out or resolved
 			}
+
+			pp := value.(*pendingPublish)
+			pp.timer.Stop()
+			state.resolveMandatory(confirmation.DeliveryTag)
+
+			if confirmation.Ack {
+				pub.publishReceipt(pp.letter, nil)
+				finish()
+			} else {
+				go func() { queue <- pp.letter }() // re-enqueue just this letter, batch keeps flowing
+			}
+
+		case ret := <-returns:
+			tag, ok := state.nextMandatoryReturn()
+			if !ok {
+				continue // no mandatory publish outstanding on this channel right now
+			}
+
+			value, ok := pending.LoadAndDelete(tag)
+			if !ok {
+				continue // already timed out
+			}
+
+			pp := value.(*pendingPublish)
+			pp.timer.Stop()
+			pub.handleReturn(chanHost, pp.letter, ret)
+			finish()
+
+		case <-allDone:
+			return
 		}
 	}
 }
 
 // PublishReceipts yields all the success and failures during all publish events. Highly recommend susbscribing to this.
-func (pub *Publisher) PublishReceipts() <-chan *models.PublishReceipt {
+func (pub *AMQPPublisher) PublishReceipts() <-chan *models.PublishReceipt {
 	return pub.publishReceipts
 }
 
+// Returns yields letters the broker couldn't route, for mandatory publishes that came back
+// over NotifyReturn. Subscribe to this if you need the original letter and return reason
+// instead of (or in addition to) the Unroutable PublishReceipt.
+func (pub *AMQPPublisher) Returns() <-chan *models.ReturnedLetter {
+	return pub.returns
+}
+
 // StartAutoPublishing starts the Publisher's auto-publishing capabilities.
-func (pub *Publisher) StartAutoPublishing() {
+func (pub *AMQPPublisher) StartAutoPublishing() {
 	pub.pubLock.Lock()
 	defer pub.pubLock.Unlock()
 
@@ -174,49 +502,55 @@ func (pub *Publisher) StartAutoPublishing() {
 	}
 }
 
-// StartAutoPublish starts auto-publishing letters queued up - is locking.
-func (pub *Publisher) startAutoPublishingLoop() {
-
-AutoPublishLoop:
-	for {
-		// Detect if we should stop publishing.
-		select {
-		case stop := <-pub.autoStop:
-			if stop {
-				break AutoPublishLoop
-			}
-		default:
-			break
-		}
-
-		// Get ChannelHost
-		chanHost := pub.ConnectionPool.GetChannel(true)
+// startAutoPublishingLoop spins up PublisherConfig.AutoPublishWorkers worker goroutines (1 if unset)
+// that share pub.letters, each holding its own ChannelHost for its lifetime, and waits for all
+// of them to drain out before reporting the Publisher as stopped.
+func (pub *AMQPPublisher) startAutoPublishingLoop() {
 
-		// Deliver letters queued in the publisher, returns true when we are to stop publishing.
-		if pub.deliverLetters(chanHost) {
-			chanHost.Close()
-			break AutoPublishLoop
-		}
+	workers := pub.Config.PublisherConfig.AutoPublishWorkers
+	if workers < 1 {
+		workers = 1
+	}
 
-		chanHost.Close()
+	// autoStop only ever carries a single stop signal, but every worker needs to see it, so
+	// fan it out by closing stopC once the signal arrives.
+	stopC := make(chan struct{})
+	go func() {
+		<-pub.autoStop
+		close(stopC)
+	}()
+
+	for i := 0; i < workers; i++ {
+		pub.autoPublishGroup.Add(1)
+		go pub.autoPublishWorker(stopC)
 	}
 
+	pub.autoPublishGroup.Wait()
+
 	pub.pubLock.Lock()
 	pub.autoStarted = false
 	pub.pubLock.Unlock()
 }
 
-func (pub *Publisher) deliverLetters(chanHost *pools.ChannelHost) bool {
+// autoPublishWorker holds one ChannelHost for its entire lifetime, reconnecting whenever the
+// channel reports closure, and drains pub.letters until stopC is closed. Every letter is
+// delivered according to PublisherConfig.PublishStrategy.
+func (pub *AMQPPublisher) autoPublishWorker(stopC chan struct{}) {
+	defer pub.autoPublishGroup.Done()
 
-DeliverLettersLoop:
+	chanHost := pub.ConnectionPool.GetChannel(true)
+
+WorkerLoop:
 	for {
-		// Listen for channel closure (close errors).
+		// Listen for channel closure (close errors) and reconnect in place.
 		// Highest priority so separated to it's own select.
 		select {
 		case errorMessage := <-chanHost.Errors():
 			if errorMessage != nil {
-				pub.errors <- fmt.Errorf("autopublisher's current channel closed\r\n[reason: %s]\r\n[code: %d]", errorMessage.Reason, errorMessage.Code)
-				break DeliverLettersLoop
+				pub.errors <- fmt.Errorf("autopublish worker's channel closed\r\n[reason: %s]\r\n[code: %d]", errorMessage.Reason, errorMessage.Code)
+				pub.confirmStates.Delete(chanHost.Channel)
+				chanHost.Close()
+				chanHost = pub.ConnectionPool.GetChannel(true)
 			}
 		default:
 			break
@@ -225,7 +559,7 @@ DeliverLettersLoop:
 		// Publish the letter.
 		select {
 		case letter := <-pub.letters:
-			pub.simplePublish(chanHost, letter)
+			pub.publishWithStrategy(chanHost, letter)
 		default:
 			if pub.sleepOnIdleInterval > 0 {
 				time.Sleep(pub.sleepOnIdleInterval)
@@ -235,20 +569,59 @@ DeliverLettersLoop:
 
 		// Detect if we should stop publishing.
 		select {
-		case stop := <-pub.autoStop:
-			if stop {
-				break DeliverLettersLoop
-			}
+		case <-stopC:
+			break WorkerLoop
 		default:
 			break
 		}
 	}
 
-	return false
+	chanHost.Close()
+}
+
+// defaultPublishConfirmTimeout bounds how long the Confirmed and Batched strategies wait for a
+// publish confirm when PublisherConfig.PublishTimeOutInterval isn't set.
+const defaultPublishConfirmTimeout = 5 * time.Second
+
+// publishWithStrategy delivers a single letter pulled off pub.letters the way
+// PublisherConfig.PublishStrategy says every worker should: fire-and-forget, pipelined through
+// a single publish confirm, or batched together with whatever else is already queued.
+func (pub *AMQPPublisher) publishWithStrategy(chanHost *pools.ChannelHost, letter *models.Letter) {
+
+	switch pub.Config.PublisherConfig.PublishStrategy {
+	case Confirmed:
+		pub.publishBatchOnChannel(chanHost, []*models.Letter{letter}, pub.publishConfirmTimeout())
+
+	case Batched:
+		letters := []*models.Letter{letter}
+	DrainLoop:
+		for {
+			select {
+			case next := <-pub.letters:
+				letters = append(letters, next)
+			default:
+				break DrainLoop
+			}
+		}
+		pub.publishBatchOnChannel(chanHost, letters, pub.publishConfirmTimeout())
+
+	default: // Simple
+		pub.simplePublish(chanHost, letter)
+	}
+}
+
+// publishConfirmTimeout returns the configured publish-confirm timeout, or
+// defaultPublishConfirmTimeout when the Publisher wasn't given one.
+func (pub *AMQPPublisher) publishConfirmTimeout() time.Duration {
+	timeout := time.Duration(pub.Config.PublisherConfig.PublishTimeOutInterval) * time.Millisecond
+	if timeout <= 0 {
+		return defaultPublishConfirmTimeout
+	}
+	return timeout
 }
 
 // StopAutoPublish stops publishing letters queued up.
-func (pub *Publisher) StopAutoPublish() {
+func (pub *AMQPPublisher) StopAutoPublish() {
 	pub.pubLock.Lock()
 	defer pub.pubLock.Unlock()
 
@@ -260,28 +633,99 @@ func (pub *Publisher) StopAutoPublish() {
 }
 
 // QueueLetters allows you to bulk queue letters that will be consumed by AutoPublish.
-// Blocks on the Letter Buffer being full.
-func (pub *Publisher) QueueLetters(letters []*models.Letter) {
+// Each letter is subject to PublisherConfig.LetterBufferFullPolicy independently; a DropOldest
+// or DropNewest policy can therefore cause part of the batch to be dropped while the rest queues.
+func (pub *AMQPPublisher) QueueLetters(letters []*models.Letter) {
 
 	for _, letter := range letters {
 
-		pub.letters <- letter
+		pub.queueLetter(letter)
+	}
+}
+
+// QueueLetter queues up a letter that will be consumed by AutoPublish, honoring
+// PublisherConfig.LetterBufferFullPolicy when the buffer is full. The default policy (Block)
+// blocks on the Letter Buffer being full, same as before this setting existed.
+func (pub *AMQPPublisher) QueueLetter(letter *models.Letter) {
+	pub.queueLetter(letter)
+}
+
+// TryQueueLetter queues up a letter without ever blocking the caller, regardless of
+// PublisherConfig.LetterBufferFullPolicy. Returns false if the buffer was full and the
+// letter was not queued.
+func (pub *AMQPPublisher) TryQueueLetter(letter *models.Letter) (queued bool) {
+	select {
+	case pub.letters <- letter:
+		return true
+	default:
+		return false
 	}
 }
 
-// QueueLetter queues up a letter that will be consumed by AutoPublish.
-// Blocks on the Letter Buffer being full.
-func (pub *Publisher) QueueLetter(letter *models.Letter) {
+// QueueDepth returns how many letters are currently buffered awaiting auto-publish.
+func (pub *AMQPPublisher) QueueDepth() int {
+	return len(pub.letters)
+}
+
+// QueueCapacity returns the configured size of the letter buffer.
+func (pub *AMQPPublisher) QueueCapacity() int {
+	return cap(pub.letters)
+}
+
+// queueLetter applies PublisherConfig.LetterBufferFullPolicy to a single letter being queued.
+func (pub *AMQPPublisher) queueLetter(letter *models.Letter) {
+
+	switch pub.Config.PublisherConfig.LetterBufferFullPolicy {
+	case DropNewest:
+		select {
+		case pub.letters <- letter:
+		default:
+			// Buffer is full - drop the incoming letter.
+		}
+
+	case DropOldest:
+		for {
+			select {
+			case pub.letters <- letter:
+				return
+			default:
+				select {
+				case <-pub.letters:
+					// Evicted the oldest letter - retry the send.
+				default:
+					// Lost the race with a concurrent consumer; give it a moment and retry.
+					if pub.sleepOnQueueFullInterval > 0 {
+						time.Sleep(pub.sleepOnQueueFullInterval)
+					}
+				}
+			}
+		}
+
+	case RejectWithReceipt:
+		select {
+		case pub.letters <- letter:
+		default:
+			pub.publishReceipt(letter, fmt.Errorf("letterid: %d was rejected - letter buffer is full (capacity: %d)", letter.LetterID, cap(pub.letters)))
+		}
 
-	pub.letters <- letter
+	default: // Block
+		pub.letters <- letter
+	}
 }
 
 // publishReceipt sends the status to the receipt channel.
-func (pub *Publisher) publishReceipt(letter *models.Letter, err error) {
+func (pub *AMQPPublisher) publishReceipt(letter *models.Letter, err error) {
+	pub.publishReceiptWithReturn(letter, err, false)
+}
+
+// publishReceiptWithReturn sends the status to the receipt channel, flagging it Unroutable
+// when the broker returned the letter off a mandatory publish.
+func (pub *AMQPPublisher) publishReceiptWithReturn(letter *models.Letter, err error, unroutable bool) {
 
 	publishReceipt := &models.PublishReceipt{
-		LetterID: letter.LetterID,
-		Error:    err,
+		LetterID:   letter.LetterID,
+		Error:      err,
+		Unroutable: unroutable,
 	}
 
 	if err == nil {
@@ -294,12 +738,12 @@ func (pub *Publisher) publishReceipt(letter *models.Letter, err error) {
 }
 
 // Errors yields all the internal errs for delivering letters.
-func (pub *Publisher) Errors() <-chan error {
+func (pub *AMQPPublisher) Errors() <-chan error {
 	return pub.errors
 }
 
 // FlushStops flushes out all the AutoStop messages.
-func (pub *Publisher) FlushStops() {
+func (pub *AMQPPublisher) FlushStops() {
 
 FlushLoop:
 	for {
@@ -312,7 +756,7 @@ FlushLoop:
 }
 
 // Shutdown cleanly shutsdown the publisher and resets it's internal state.
-func (pub *Publisher) Shutdown(shutdownPools bool) {
+func (pub *AMQPPublisher) Shutdown(shutdownPools bool) {
 	pub.StopAutoPublish()
 
 	if shutdownPools { // in case the ChannelPool is shared between structs, you can prevent it from shutting down